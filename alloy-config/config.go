@@ -0,0 +1,63 @@
+// Package alloyconfig loads AlloyClient configuration from layered
+// sources: environment variables, YAML/JSON files in conf.d-style
+// directories, and command-line flags, with later sources overriding
+// earlier ones.
+package alloyconfig
+
+import (
+	"os"
+	"time"
+)
+
+// Config is the expanded configuration shape populated by a ConfigLoader.
+// Field names correspond to the dotted keys accepted by file- and
+// flag-based Providers (e.g. TraceEndpoint <-> "trace.endpoint").
+type Config struct {
+	TraceEndpoint         string
+	LogEndpoint           string
+	LogHTTPProxy          string
+	MetricEndpoint        string
+	MeterInterval         time.Duration
+	CertFilePath          string
+	ServiceName           string
+	TracerName            string
+	RateLimit             float64
+	RateBurst             int
+	TLSInsecureSkipVerify bool
+	TLSCAFile             string
+}
+
+func defaultConfig() Config {
+	return Config{
+		TraceEndpoint:  "localhost:4318",
+		LogEndpoint:    "localhost:4318",
+		MetricEndpoint: "localhost:4318",
+		MeterInterval:  15 * time.Second,
+		CertFilePath:   "/etc/config/grafana-alloy.crt",
+		ServiceName:    "addi",
+		TracerName:     "addi-tracer",
+		RateLimit:      10,
+		RateBurst:      20,
+	}
+}
+
+// LoadConfig is a thin wrapper over the default loader (environment
+// variables only) so existing callers keep working unchanged.
+func LoadConfig() Config {
+	cfg, err := NewLoader().AddEnv().Load()
+	if err != nil {
+		// Load fails here when an ALLOY_* variable holds a value its key
+		// can't parse (e.g. a non-numeric ALLOY_RATE_BURST). Fall back to
+		// defaults rather than panic; the env values that did parse are
+		// dropped too.
+		return defaultConfig()
+	}
+	return cfg
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,124 @@
+package alloyconfig
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ConfigLoader composes an ordered list of Providers into a single Config,
+// similar to layered configuration systems: each Provider's keys are
+// applied in the order it was added, so a later Provider overrides an
+// earlier one's value for the same key.
+//
+// Typical usage:
+//
+//	cfg, err := NewLoader().AddPaths("/etc/alloy", "./config").AddEnv().AddFlags(flagSet).Load()
+type ConfigLoader struct {
+	providers []Provider
+}
+
+// NewLoader returns an empty ConfigLoader. Providers are applied in the
+// order they are added below.
+func NewLoader() *ConfigLoader {
+	return &ConfigLoader{}
+}
+
+// AddPaths adds one fileDirProvider per directory, each picking up every
+// *.yaml/*.yml/*.json file it contains in lexical order.
+func (l *ConfigLoader) AddPaths(paths ...string) *ConfigLoader {
+	for _, p := range paths {
+		l.providers = append(l.providers, fileDirProvider{dir: p})
+	}
+	return l
+}
+
+// AddEnv adds a Provider reading the legacy ALLOY_* environment variables.
+func (l *ConfigLoader) AddEnv() *ConfigLoader {
+	l.providers = append(l.providers, envProvider{})
+	return l
+}
+
+// AddFlags adds a Provider reading dotted-key values from an already
+// registered, already-parsed flag.FlagSet.
+func (l *ConfigLoader) AddFlags(fs *flag.FlagSet) *ConfigLoader {
+	l.providers = append(l.providers, flagProvider{fs: fs})
+	return l
+}
+
+// Load runs every Provider in order and applies its keys to a Config
+// seeded with the current defaults. A bad value only skips that one key -
+// it doesn't discard keys already applied from this or earlier Providers.
+// On error, the returned Config is still the best-effort result of every
+// key that did parse; the error names every offending file (or env var /
+// flag) and key so callers can decide whether to use the partial Config
+// or treat it as fatal.
+func (l *ConfigLoader) Load() (Config, error) {
+	cfg := defaultConfig()
+	var errs []error
+
+	for _, p := range l.providers {
+		kvs, err := p.Load()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, kv := range kvs {
+			if err := applyKey(&cfg, kv.Key, kv.Value); err != nil {
+				errs = append(errs, fmt.Errorf("config: invalid value for key %q from %s: %w", kv.Key, kv.Source, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return cfg, fmt.Errorf("config: %d error(s) loading config: %v", len(errs), errs)
+	}
+	return cfg, nil
+}
+
+func applyKey(cfg *Config, key, value string) error {
+	switch key {
+	case "trace.endpoint":
+		cfg.TraceEndpoint = value
+	case "log.endpoint":
+		cfg.LogEndpoint = value
+	case "log.http_proxy":
+		cfg.LogHTTPProxy = value
+	case "metric.endpoint":
+		cfg.MetricEndpoint = value
+	case "meter.interval":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		cfg.MeterInterval = d
+	case "cert.file_path":
+		cfg.CertFilePath = value
+	case "service.name":
+		cfg.ServiceName = value
+	case "tracer.name":
+		cfg.TracerName = value
+	case "rate.limit":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		cfg.RateLimit = f
+	case "rate.burst":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		cfg.RateBurst = n
+	case "tls.insecure_skip_verify":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		cfg.TLSInsecureSkipVerify = b
+	case "tls.ca_file":
+		cfg.TLSCAFile = value
+	}
+	return nil
+}
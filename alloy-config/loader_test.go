@@ -0,0 +1,77 @@
+package alloyconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigLoader_FilesOverrideDefaults(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "01-base.yaml"), "trace:\n  endpoint: base-endpoint\nservice:\n  name: base-service\n")
+	writeFile(t, filepath.Join(dir, "02-override.json"), `{"trace": {"endpoint": "override-endpoint"}}`)
+
+	cfg, err := NewLoader().AddPaths(dir).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "override-endpoint", cfg.TraceEndpoint)
+	assert.Equal(t, "base-service", cfg.ServiceName)
+}
+
+func TestConfigLoader_EnvOverridesFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "base.yaml"), "trace:\n  endpoint: file-endpoint\n")
+
+	os.Setenv("ALLOY_ENDPOINT", "env-endpoint")
+	defer os.Unsetenv("ALLOY_ENDPOINT")
+
+	cfg, err := NewLoader().AddPaths(dir).AddEnv().Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "env-endpoint", cfg.TraceEndpoint)
+}
+
+func TestConfigLoader_MissingPathIsNotAnError(t *testing.T) {
+	cfg, err := NewLoader().AddPaths("/does/not/exist").Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:4318", cfg.TraceEndpoint)
+}
+
+func TestConfigLoader_InvalidValueNamesFileAndKey(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bad.yaml")
+	writeFile(t, path, "rate:\n  burst: not-a-number\n")
+
+	_, err := NewLoader().AddPaths(dir).Load()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "rate.burst")
+	assert.Contains(t, err.Error(), path)
+}
+
+func TestConfigLoader_InvalidValuePreservesOtherKeys(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "mixed.yaml"), "trace:\n  endpoint: good-endpoint\nrate:\n  burst: not-a-number\n")
+
+	cfg, err := NewLoader().AddPaths(dir).Load()
+	assert.Error(t, err)
+	assert.Equal(t, "good-endpoint", cfg.TraceEndpoint)
+}
+
+func TestConfigLoader_RateAndTLSKeys(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "rate.yaml"), "rate:\n  limit: 5\n  burst: 15\ntls:\n  insecure_skip_verify: true\n  ca_file: /etc/alloy/ca.pem\n")
+
+	cfg, err := NewLoader().AddPaths(dir).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, cfg.RateLimit)
+	assert.Equal(t, 15, cfg.RateBurst)
+	assert.True(t, cfg.TLSInsecureSkipVerify)
+	assert.Equal(t, "/etc/alloy/ca.pem", cfg.TLSCAFile)
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("failed to write test file %s: %v", path, err)
+	}
+}
@@ -0,0 +1,164 @@
+package alloyconfig
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KeyValue is one dotted-key setting read from a Provider, tagged with
+// where it came from so ConfigLoader can report precisely which source a
+// bad value belongs to.
+type KeyValue struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// Provider yields the settings it knows about as dotted keys (e.g.
+// "trace.endpoint", "rate.limit"). ConfigLoader applies Providers in the
+// order they were added, so a later Provider's keys override an earlier
+// one's.
+type Provider interface {
+	Load() ([]KeyValue, error)
+}
+
+// envProvider reads the legacy ALLOY_* environment variables, the same
+// names alloyinterface.LoadConfig has always used.
+type envProvider struct{}
+
+var envKeys = []struct {
+	dotted string
+	env    string
+}{
+	{"trace.endpoint", "ALLOY_ENDPOINT"},
+	{"log.endpoint", "ALLOY_LOG_ENDPOINT"},
+	{"log.http_proxy", "ALLOY_LOG_HTTP_PROXY"},
+	{"metric.endpoint", "ALLOY_METRIC_ENDPOINT"},
+	{"meter.interval", "ALLOY_METER_INTERVAL"},
+	{"cert.file_path", "ALLOY_CERTFILE_PATH"},
+	{"service.name", "ALLOY_SERVICE_NAME"},
+	{"tracer.name", "ALLOY_TRACER_NAME"},
+	{"rate.limit", "ALLOY_RATE_LIMIT"},
+	{"rate.burst", "ALLOY_RATE_BURST"},
+	{"tls.insecure_skip_verify", "ALLOY_TLS_INSECURE_SKIP_VERIFY"},
+	{"tls.ca_file", "ALLOY_TLS_CA_FILE"},
+}
+
+func (envProvider) Load() ([]KeyValue, error) {
+	var kvs []KeyValue
+	for _, k := range envKeys {
+		if v := getEnv(k.env, ""); v != "" {
+			kvs = append(kvs, KeyValue{Key: k.dotted, Value: v, Source: "env:" + k.env})
+		}
+	}
+	return kvs, nil
+}
+
+// fileDirProvider walks a directory (non-recursively) for *.yaml/*.yml/
+// *.json files in lexical order and flattens each into dotted KeyValues,
+// so operators can drop site-specific overrides into a conf.d-style
+// directory. Missing directories are not an error - AddPaths is meant to
+// take optional override locations.
+type fileDirProvider struct {
+	dir string
+}
+
+func (p fileDirProvider) Load() ([]KeyValue, error) {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("config: failed to read %s: %w", p.dir, err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if ext == ".yaml" || ext == ".yml" || ext == ".json" {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var kvs []KeyValue
+	for _, name := range names {
+		path := filepath.Join(p.dir, name)
+		fileKVs, err := loadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, fileKVs...)
+	}
+	return kvs, nil
+}
+
+func loadFile(path string) ([]KeyValue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: failed to read %s: %w", path, err)
+	}
+
+	var raw map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("config: failed to parse %s: %w", path, err)
+		}
+	default:
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("config: failed to parse %s: %w", path, err)
+		}
+	}
+
+	var kvs []KeyValue
+	for k, v := range flatten("", raw) {
+		kvs = append(kvs, KeyValue{Key: k, Value: v, Source: path})
+	}
+	return kvs, nil
+}
+
+// flatten turns a nested map (as produced by YAML/JSON unmarshaling) into
+// dotted keys, e.g. {"trace": {"endpoint": "x"}} -> {"trace.endpoint": "x"}.
+func flatten(prefix string, m map[string]interface{}) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			for fk, fv := range flatten(key, val) {
+				out[fk] = fv
+			}
+		default:
+			out[key] = fmt.Sprintf("%v", val)
+		}
+	}
+	return out
+}
+
+// flagProvider reads dotted-key settings from an already-parsed
+// flag.FlagSet, picking up only flags the caller actually set.
+type flagProvider struct {
+	fs *flag.FlagSet
+}
+
+func (p flagProvider) Load() ([]KeyValue, error) {
+	var kvs []KeyValue
+	p.fs.Visit(func(f *flag.Flag) {
+		kvs = append(kvs, KeyValue{Key: f.Name, Value: f.Value.String(), Source: "flag:" + f.Name})
+	})
+	return kvs, nil
+}
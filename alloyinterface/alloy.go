@@ -1,20 +1,20 @@
 package alloyinterface
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
@@ -25,10 +25,15 @@ import (
 
 type AlloyClient struct {
 	Tracer        trace.Tracer
-	Logger        zerolog.Logger
+	Meter         metric.Meter
+	Logger        Logger
 	cfg           Config
 	traceShutdown func(context.Context) error
+	logShutdown   func(context.Context) error
+	meterShutdown func(context.Context) error
 	rateLimiter   *rate.Limiter
+	otelLogger    otellog.Logger
+	instruments   *instrumentCache
 }
 
 // Allow injection for testing
@@ -37,28 +42,76 @@ var (
 	initLogFn    = initLog
 )
 
+// NewAlloyClient builds an AlloyClient with the default zerolog logger,
+// rotated to disk via lumberjack. Use NewAlloyClientWithOptions to plug in
+// a different Logger or writer.
 func NewAlloyClient(ctx context.Context) (*AlloyClient, error) {
-	cfg := LoadConfig()
+	return NewAlloyClientWithOptions(ctx)
+}
+
+// NewAlloyClientWithOptions builds an AlloyClient, applying opts over the
+// defaults used by NewAlloyClient. See WithLogger, WithWriter,
+// WithLumberjack, and WithConfigPaths.
+func NewAlloyClientWithOptions(ctx context.Context, opts ...Option) (*AlloyClient, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cfg, err := loadConfig(o.configPaths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
 
 	tracer, closeFn, err := initTracerFn(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	logger, err := initLogFn()
+	logger, err := initLogFn(o)
 	if err != nil {
 		return nil, err
 	}
 
-	// Initialize the rate limiter with a limit of 10 requests per second and a burst size of 20
-	rateLimiter := rate.NewLimiter(10, 20)
+	rateLimiter := rate.NewLimiter(rate.Limit(cfg.RateLimit), cfg.RateBurst)
+
+	meter, meterShutdown, err := initMeterFn(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	instruments := &instrumentCache{meter: meter}
+
+	var inFlight atomic.Int64
+	hooks := metricsHooks{
+		rateLimitRejectedFn: func(c context.Context) { _ = instruments.recordCounter(c, metricRateLimitRejected, 1) },
+		inFlightDeltaFn: func(c context.Context, delta int64) {
+			current := inFlight.Add(delta)
+			_ = instruments.recordGauge(c, metricHTTPInFlight, float64(current))
+		},
+		// Recorded at the transport, not around otelLogger.Emit, since Emit
+		// only enqueues onto the batching LoggerProvider and returns - this
+		// is where the HTTP round trip to the collector actually happens.
+		roundTripFn: func(c context.Context, d time.Duration) {
+			_ = instruments.recordHistogram(c, metricLogShipLatency, d.Seconds())
+		},
+	}
+
+	otelLogger, logShutdown, err := initLogProviderFn(ctx, cfg, rateLimiter, hooks)
+	if err != nil {
+		return nil, err
+	}
 
 	return &AlloyClient{
 		Tracer:        tracer,
+		Meter:         meter,
 		Logger:        logger,
 		cfg:           cfg,
 		traceShutdown: closeFn,
+		logShutdown:   logShutdown,
+		meterShutdown: meterShutdown,
 		rateLimiter:   rateLimiter,
+		otelLogger:    otelLogger,
+		instruments:   instruments,
 	}, nil
 }
 
@@ -79,8 +132,10 @@ func (ac *AlloyClient) AddSpanWithAttr(ctx context.Context, tracerName string, a
 			Err(err).
 			Str("tracer_name", tracerName).
 			Msg("AddSpanWithAttr: Failed to start tracing")
+		ac.recordSpanFailed(ctx, tracerName)
 		return fmt.Errorf("failed to start tracing: %v", err)
 	}
+	ac.recordSpanStarted(ctx, tracerName)
 	span.SetAttributes(attrs...)
 	span.End()
 
@@ -113,8 +168,10 @@ func (ac *AlloyClient) AddSpan(ctx context.Context, tracerName string, title str
 			Err(err).
 			Str("tracer_name", tracerName).
 			Msg("AddSpan: Failed to start tracing")
+		ac.recordSpanFailed(ctx, tracerName)
 		return fmt.Errorf("failed to start tracing: %v", err)
 	}
+	ac.recordSpanStarted(ctx, tracerName)
 
 	span.SetAttributes(attribute.String(title, msgBody))
 	span.End()
@@ -127,88 +184,54 @@ func (ac *AlloyClient) AddSpan(ctx context.Context, tracerName string, title str
 	return nil
 }
 
-func (ac *AlloyClient) AddLog(ctx context.Context, level zerolog.Level, msg string) (*http.Response, error) {
-	if err := ac.rateLimiter.Wait(ctx); err != nil {
-		ac.Logger.Error().Err(err).Msg("AddLog: Rate limit exceeded")
-		return nil, fmt.Errorf("rate limit exceeded: %v", err)
-	}
-
+// AddLog emits a structured log record through the OTLP log pipeline. The
+// pipeline batches and ships records asynchronously, so a nil error here
+// only means the record was accepted locally, not that it reached the
+// collector.
+func (ac *AlloyClient) AddLog(ctx context.Context, level zerolog.Level, msg string, attrs ...attribute.KeyValue) error {
 	if level < zerolog.DebugLevel || level > zerolog.PanicLevel {
 		ac.Logger.Error().Msg("AddLog: invalid log level")
-		return nil, errors.New("invalid log level")
+		return errors.New("invalid log level")
 	}
 	if len(msg) == 0 {
-		ac.Logger.Error().Msg("AddLog: Log message cannot be empty")
-		return nil, errors.New("log message cannot be empty")
+		ac.Logger.Error().Msg("AddLog: log message cannot be empty")
+		return errors.New("log message cannot be empty")
+	}
+	if ac.otelLogger == nil {
+		ac.Logger.Error().Msg("AddLog: log provider not initialized")
+		return errors.New("log provider not initialized")
 	}
 
-	requestID := ctx.Value("request_id")
+	requestID := ctx.Value(requestIDContextKey)
 	if requestID == nil {
 		requestID = "unknown"
 	}
 
-	logRecord := map[string]interface{}{
-		"timestamp":    time.Now().Format(time.RFC3339),
-		"level":        level,
-		"message":      msg,
-		"is_secret":    "false",
-		"service_name": ac.cfg.ServiceName,
-		"request_id":   requestID,
-	}
-
-	jsonBytes, err := json.Marshal(logRecord)
-	if err != nil {
-		ac.Logger.Error().Err(err).Msg("AddLog: failed to marshal log record")
-		return nil, fmt.Errorf("failed to marshal log record: %v", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ac.cfg.LogEndpoint+"/loki/api/v1/raw", bytes.NewBuffer(jsonBytes))
-	if err != nil {
-		ac.Logger.Error().Err(err).Msg("AddLog: failed to create srequest")
-		return nil, fmt.Errorf("failed to create request: %v", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
+	var record otellog.Record
+	record.SetTimestamp(time.Now())
+	record.SetSeverity(zerologToOtelSeverity(level))
+	record.SetSeverityText(level.String())
+	record.SetBody(otellog.StringValue(msg))
+	record.AddAttributes(
+		otellog.String("service_name", ac.cfg.ServiceName),
+		otellog.String("request_id", fmt.Sprintf("%v", requestID)),
+	)
+	for _, a := range attrs {
+		record.AddAttributes(toLogKeyValue(a))
 	}
 
 	ac.Logger.Info().
-		Str("Status", "Preparing to send logs").
 		Str("level", level.String()).
 		Str("service_name", ac.cfg.ServiceName).
 		Str("request_id", fmt.Sprintf("%v", requestID)).
 		Msg("AddLog: " + msg)
 
-	resp, err := client.Do(req)
-	if err != nil {
-		ac.Logger.Error().
-			Err(err).
-			Str("service_name", ac.cfg.ServiceName).
-			Str("request_id", fmt.Sprintf("%v", requestID)).
-			Msg("AddLog: Failed to send HTTP request")
-		return resp, fmt.Errorf("failed to send request: %v", err)
-	}
-
-	if resp != nil {
-		defer resp.Body.Close()
-	}
-	if resp.StatusCode >= 300 {
-		ac.Logger.Error().
-			Int("status_code", resp.StatusCode).
-			Str("service_name", ac.cfg.ServiceName).
-			Str("request_id", fmt.Sprintf("%v", requestID)).
-			Msg("AddLog: Received non-success status code from log endpoint")
-		return resp, fmt.Errorf("failed to send log record, status code: %d", resp.StatusCode)
-	}
-
-	ac.Logger.Info().
-		Int("status_code", resp.StatusCode).
-		Str("service_name", ac.cfg.ServiceName).
-		Str("request_id", fmt.Sprintf("%v", requestID)).
-		Msg("AddLog: Log record sent successfully")
+	// Emit only enqueues the record onto the batching LoggerProvider; it
+	// doesn't wait for delivery. Actual ship latency to the collector is
+	// measured in rateLimitedTransport, where the HTTP round trip happens.
+	ac.otelLogger.Emit(ctx, record)
 
-	return resp, nil
+	return nil
 }
 
 func (ac *AlloyClient) SetRateLimit(limit rate.Limit, burst int) {
@@ -232,6 +255,18 @@ func (ac *AlloyClient) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if ac.logShutdown != nil {
+		if err := ac.logShutdown(ctx); err != nil {
+			shutdownErrs = append(shutdownErrs, fmt.Errorf("failed to shutdown log provider: %v", err))
+		}
+	}
+
+	if ac.meterShutdown != nil {
+		if err := ac.meterShutdown(ctx); err != nil {
+			shutdownErrs = append(shutdownErrs, fmt.Errorf("failed to shutdown meter provider: %v", err))
+		}
+	}
+
 	if len(shutdownErrs) > 0 {
 		ac.Logger.Error().Err(fmt.Errorf("%v", shutdownErrs)).Msg("Shutdown: Errors occurred during shutdown")
 		return fmt.Errorf("shutdown errors: %v", shutdownErrs)
@@ -243,9 +278,14 @@ func initTracer(ctx context.Context, cfg Config) (trace.Tracer, func(context.Con
 	var exporter sdktrace.SpanExporter
 	var err error
 
+	tlsCfg, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	httpOpts := []otlptracehttp.Option{
 		otlptracehttp.WithEndpoint(cfg.TraceEndpoint),
-		otlptracehttp.WithTLSClientConfig(&tls.Config{InsecureSkipVerify: false}),
+		otlptracehttp.WithTLSClientConfig(tlsCfg),
 	}
 	exporter, err = otlptracehttp.New(ctx, httpOpts...)
 	if err != nil {
@@ -270,23 +310,38 @@ func initTracer(ctx context.Context, cfg Config) (trace.Tracer, func(context.Con
 	return otel.Tracer(cfg.TracerName), tp.Shutdown, nil
 }
 
-func initLog() (zerolog.Logger, error) {
-	// Ensure the logs directory exists
-	logDir := "logs"
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		return zerolog.Logger{}, fmt.Errorf("failed to create log directory: %v", err)
-	}
-
-	// Initialize the logger
-	return zerolog.New(zerolog.ConsoleWriter{
-		Out: &lumberjack.Logger{
-			Filename:   logDir + "/alloy.log",
-			MaxSize:    10, // Max megabytes before log is rotated
-			MaxBackups: 3,  // Max number of old log files to keep
-			MaxAge:     28, // Max number of days to retain old log files
-			Compress:   true,
-		},
-	}).With().Timestamp().Logger(), nil
+// defaultLumberjack is the rotation config NewAlloyClient has always used;
+// WithLumberjack overrides it.
+func defaultLumberjack() *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   "logs/alloy.log",
+		MaxSize:    10, // Max megabytes before log is rotated
+		MaxBackups: 3,  // Max number of old log files to keep
+		MaxAge:     28, // Max number of days to retain old log files
+		Compress:   true,
+	}
+}
+
+func initLog(o options) (Logger, error) {
+	if o.logger != nil {
+		return o.logger, nil
+	}
+
+	out := o.logWriter
+	if out == nil {
+		lj := o.lumberjack
+		if lj == nil {
+			lj = defaultLumberjack()
+		}
+		if dir := filepath.Dir(lj.Filename); dir != "." {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, fmt.Errorf("failed to create log directory: %v", err)
+			}
+		}
+		out = lj
+	}
+
+	return NewZerologLogger(zerolog.New(zerolog.ConsoleWriter{Out: out}).With().Timestamp().Logger()), nil
 }
 
 func (ac *AlloyClient) startTrace(ctx context.Context, name string) (context.Context, trace.Span, error) {
@@ -1,30 +1,90 @@
 package alloyinterface
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"os"
+	"time"
+
+	alloyconfig "github.com/TTEC-Engage-Digital/alloy-interface/alloy-config"
 )
 
 type Config struct {
-	TraceEndpoint string
-	LogEndpoint   string
-	CertFilePath  string
-	ServiceName   string
-	TracerName    string
+	TraceEndpoint         string
+	LogEndpoint           string
+	LogHTTPProxy          string
+	MetricEndpoint        string
+	MeterInterval         time.Duration
+	CertFilePath          string
+	ServiceName           string
+	TracerName            string
+	RateLimit             float64
+	RateBurst             int
+	TLSInsecureSkipVerify bool
+	TLSCAFile             string
 }
 
+// LoadConfig loads configuration from the legacy ALLOY_* environment
+// variables only, same as it always has. Use WithConfigPaths to also layer
+// in conf.d-style directories ahead of the environment.
 func LoadConfig() Config {
-	return Config{
-		TraceEndpoint: getEnv("ALLOY_ENDPOINT", "localhost:4318"),
-		LogEndpoint:   getEnv("ALLOY_LOG_ENDPOINT", "http://localhost:9999"),
-		CertFilePath:  getEnv("ALLOY_CERTFILE_PATH", "/etc/config/grafana-alloy.crt"),
-		ServiceName:   getEnv("ALLOY_SERVICE_NAME", "addi"),
-		TracerName:    getEnv("ALLOY_TRACER_NAME", "addi-tracer"),
+	cfg, _ := loadConfig(nil)
+	return cfg
+}
+
+// loadConfig builds an alloyconfig.ConfigLoader, layering any configPaths
+// (conf.d-style directories, lowest precedence) under the ALLOY_* env vars
+// (highest precedence), and converts the result to the local Config shape
+// every exporter/limiter in this package already takes. A bad key's error
+// is returned, not swallowed, but cfg is still the best-effort result of
+// every key that did parse - see ConfigLoader.Load.
+func loadConfig(configPaths []string) (Config, error) {
+	loader := alloyconfig.NewLoader()
+	if len(configPaths) > 0 {
+		loader.AddPaths(configPaths...)
 	}
+	loader.AddEnv()
+
+	cfg, err := loader.Load()
+
+	return Config{
+		TraceEndpoint:         cfg.TraceEndpoint,
+		LogEndpoint:           cfg.LogEndpoint,
+		LogHTTPProxy:          cfg.LogHTTPProxy,
+		MetricEndpoint:        cfg.MetricEndpoint,
+		MeterInterval:         cfg.MeterInterval,
+		CertFilePath:          cfg.CertFilePath,
+		ServiceName:           cfg.ServiceName,
+		TracerName:            cfg.TracerName,
+		RateLimit:             cfg.RateLimit,
+		RateBurst:             cfg.RateBurst,
+		TLSInsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+		TLSCAFile:             cfg.TLSCAFile,
+	}, err
 }
 
-func getEnv(key, fallback string) string {
-	if value, ok := os.LookupEnv(key); ok {
-		return value
+// buildTLSConfig turns the TLS* fields of cfg into a *tls.Config, loading
+// and parsing TLSCAFile into a RootCAs pool when one is given. Every OTLP
+// exporter in this package (trace, metric, log) builds its client TLS
+// config this way so the cfg.TLS* knobs actually take effect.
+func buildTLSConfig(cfg Config) (*tls.Config, error) {
+	tlsCfg := &tls.Config{InsecureSkipVerify: cfg.TLSInsecureSkipVerify}
+
+	if cfg.TLSCAFile == "" {
+		return tlsCfg, nil
+	}
+
+	pem, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS CA file %s: %w", cfg.TLSCAFile, err)
 	}
-	return fallback
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("failed to parse TLS CA file %s", cfg.TLSCAFile)
+	}
+	tlsCfg.RootCAs = pool
+
+	return tlsCfg, nil
 }
@@ -0,0 +1,123 @@
+package alloyinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+)
+
+// requestIDContextKey is the context key AddLog and every Logger adapter's
+// WithContext read the inbound request id from.
+const requestIDContextKey = "request_id"
+
+// Logger is the structured logging surface AlloyClient depends on, so
+// services that have standardized on a different logger than the zerolog
+// default can plug their own instance in via
+// NewAlloyClientWithOptions(ctx, WithLogger(l)). See NewZerologLogger,
+// NewZapLogger, and NewHCLogLogger for the built-in adapters.
+type Logger interface {
+	Debug() Event
+	Info() Event
+	Warn() Event
+	Error() Event
+	With() FieldContext
+
+	// WithContext binds the request_id carried on ctx (the same key AddLog
+	// already reads) onto every subsequent entry from the returned Logger.
+	WithContext(ctx context.Context) Logger
+}
+
+// Event is one structured log entry being assembled via field setters and
+// emitted with Msg. It mirrors zerolog's event-chaining API so existing
+// call sites read unchanged under the interface.
+type Event interface {
+	Str(key, value string) Event
+	Int(key string, value int) Event
+	Err(err error) Event
+	Msg(msg string)
+}
+
+// FieldContext collects fields to bind permanently onto a child Logger,
+// mirroring zerolog.Context (logger.With().Str(...).Logger()).
+type FieldContext interface {
+	Str(key, value string) FieldContext
+	Int(key string, value int) FieldContext
+	Err(err error) FieldContext
+	Logger() Logger
+}
+
+// zerologLogger adapts a zerolog.Logger to the Logger interface. It is the
+// default backend and preserves today's console+lumberjack behavior.
+type zerologLogger struct {
+	l zerolog.Logger
+}
+
+// NewZerologLogger wraps an existing zerolog.Logger as a Logger.
+func NewZerologLogger(l zerolog.Logger) Logger {
+	return zerologLogger{l: l}
+}
+
+func (z zerologLogger) Debug() Event { return zerologEvent{e: z.l.Debug()} }
+func (z zerologLogger) Info() Event  { return zerologEvent{e: z.l.Info()} }
+func (z zerologLogger) Warn() Event  { return zerologEvent{e: z.l.Warn()} }
+func (z zerologLogger) Error() Event { return zerologEvent{e: z.l.Error()} }
+
+func (z zerologLogger) With() FieldContext {
+	return zerologFieldContext{c: z.l.With()}
+}
+
+// WithContext implements Logger.
+func (z zerologLogger) WithContext(ctx context.Context) Logger {
+	requestID := ctx.Value(requestIDContextKey)
+	if requestID == nil {
+		return z
+	}
+	return zerologLogger{l: z.l.With().Str("request_id", fmt.Sprintf("%v", requestID)).Logger()}
+}
+
+type zerologEvent struct {
+	e *zerolog.Event
+}
+
+func (e zerologEvent) Str(key, value string) Event {
+	e.e.Str(key, value)
+	return e
+}
+
+func (e zerologEvent) Int(key string, value int) Event {
+	e.e.Int(key, value)
+	return e
+}
+
+func (e zerologEvent) Err(err error) Event {
+	e.e.Err(err)
+	return e
+}
+
+func (e zerologEvent) Msg(msg string) {
+	e.e.Msg(msg)
+}
+
+type zerologFieldContext struct {
+	c zerolog.Context
+}
+
+func (f zerologFieldContext) Str(key, value string) FieldContext {
+	f.c = f.c.Str(key, value)
+	return f
+}
+
+func (f zerologFieldContext) Int(key string, value int) FieldContext {
+	f.c = f.c.Int(key, value)
+	return f
+}
+
+func (f zerologFieldContext) Err(err error) FieldContext {
+	f.c = f.c.Err(err)
+	return f
+}
+
+func (f zerologFieldContext) Logger() Logger {
+	return zerologLogger{l: f.c.Logger()}
+}
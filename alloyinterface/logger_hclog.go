@@ -0,0 +1,87 @@
+package alloyinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// hclogLogger adapts an hclog.Logger to the Logger interface.
+type hclogLogger struct {
+	l hclog.Logger
+}
+
+// NewHCLogLogger wraps an existing hclog.Logger as a Logger.
+func NewHCLogLogger(l hclog.Logger) Logger {
+	return hclogLogger{l: l}
+}
+
+func (h hclogLogger) Debug() Event { return &hclogEvent{logFn: h.l.Debug} }
+func (h hclogLogger) Info() Event  { return &hclogEvent{logFn: h.l.Info} }
+func (h hclogLogger) Warn() Event  { return &hclogEvent{logFn: h.l.Warn} }
+func (h hclogLogger) Error() Event { return &hclogEvent{logFn: h.l.Error} }
+
+func (h hclogLogger) With() FieldContext {
+	return &hclogFieldContext{base: h.l}
+}
+
+// WithContext implements Logger.
+func (h hclogLogger) WithContext(ctx context.Context) Logger {
+	requestID := ctx.Value(requestIDContextKey)
+	if requestID == nil {
+		return h
+	}
+	return hclogLogger{l: h.l.With("request_id", fmt.Sprintf("%v", requestID))}
+}
+
+// hclogEvent accumulates key/value pairs for a single log call; hclog
+// takes them as a flat args list rather than a typed Field, so Msg is
+// where the accumulated pairs are actually written out.
+type hclogEvent struct {
+	logFn func(msg string, args ...interface{})
+	args  []interface{}
+}
+
+func (e *hclogEvent) Str(key, value string) Event {
+	e.args = append(e.args, key, value)
+	return e
+}
+
+func (e *hclogEvent) Int(key string, value int) Event {
+	e.args = append(e.args, key, value)
+	return e
+}
+
+func (e *hclogEvent) Err(err error) Event {
+	e.args = append(e.args, "error", err)
+	return e
+}
+
+func (e *hclogEvent) Msg(msg string) {
+	e.logFn(msg, e.args...)
+}
+
+type hclogFieldContext struct {
+	base hclog.Logger
+	args []interface{}
+}
+
+func (c *hclogFieldContext) Str(key, value string) FieldContext {
+	c.args = append(c.args, key, value)
+	return c
+}
+
+func (c *hclogFieldContext) Int(key string, value int) FieldContext {
+	c.args = append(c.args, key, value)
+	return c
+}
+
+func (c *hclogFieldContext) Err(err error) FieldContext {
+	c.args = append(c.args, "error", err)
+	return c
+}
+
+func (c *hclogFieldContext) Logger() Logger {
+	return hclogLogger{l: c.base.With(c.args...)}
+}
@@ -0,0 +1,58 @@
+package alloyinterface
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestZerologLogger_EventAndFieldContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewZerologLogger(zerolog.New(&buf))
+
+	logger.Error().Str("k", "v").Err(errors.New("boom")).Msg("failed")
+	assert.Contains(t, buf.String(), `"k":"v"`)
+	assert.Contains(t, buf.String(), `"message":"failed"`)
+
+	buf.Reset()
+	logger.With().Str("service", "addi").Logger().Info().Msg("ready")
+	assert.Contains(t, buf.String(), `"service":"addi"`)
+}
+
+func TestZerologLogger_WithContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewZerologLogger(zerolog.New(&buf))
+
+	ctx := context.WithValue(context.Background(), requestIDContextKey, "abc-123")
+	logger.WithContext(ctx).Info().Msg("hello")
+	assert.Contains(t, buf.String(), `"request_id":"abc-123"`)
+}
+
+func TestZapLogger_EventAndFieldContext(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	logger := NewZapLogger(zap.New(core))
+
+	logger.Warn().Str("k", "v").Msg("careful")
+	logger.With().Int("n", 1).Logger().Error().Msg("boom")
+
+	entries := logs.All()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "careful", entries[0].Message)
+	assert.Equal(t, "boom", entries[1].Message)
+}
+
+func TestHCLogLogger_EventAndFieldContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewHCLogLogger(hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Debug}))
+
+	logger.Info().Str("k", "v").Msg("hi")
+	assert.Contains(t, buf.String(), "hi")
+	assert.Contains(t, buf.String(), "k=v")
+}
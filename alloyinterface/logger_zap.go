@@ -0,0 +1,87 @@
+package alloyinterface
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// zapLogger adapts a *zap.Logger to the Logger interface.
+type zapLogger struct {
+	l *zap.Logger
+}
+
+// NewZapLogger wraps an existing *zap.Logger as a Logger.
+func NewZapLogger(l *zap.Logger) Logger {
+	return zapLogger{l: l}
+}
+
+func (z zapLogger) Debug() Event { return &zapEvent{logFn: z.l.Debug} }
+func (z zapLogger) Info() Event  { return &zapEvent{logFn: z.l.Info} }
+func (z zapLogger) Warn() Event  { return &zapEvent{logFn: z.l.Warn} }
+func (z zapLogger) Error() Event { return &zapEvent{logFn: z.l.Error} }
+
+func (z zapLogger) With() FieldContext {
+	return &zapFieldContext{base: z.l}
+}
+
+// WithContext implements Logger.
+func (z zapLogger) WithContext(ctx context.Context) Logger {
+	requestID := ctx.Value(requestIDContextKey)
+	if requestID == nil {
+		return z
+	}
+	return zapLogger{l: z.l.With(zap.String("request_id", fmt.Sprintf("%v", requestID)))}
+}
+
+// zapEvent accumulates fields for a single log call; zap has no event
+// handle of its own, so Msg is where the accumulated fields are actually
+// written out.
+type zapEvent struct {
+	logFn  func(msg string, fields ...zap.Field)
+	fields []zap.Field
+}
+
+func (e *zapEvent) Str(key, value string) Event {
+	e.fields = append(e.fields, zap.String(key, value))
+	return e
+}
+
+func (e *zapEvent) Int(key string, value int) Event {
+	e.fields = append(e.fields, zap.Int(key, value))
+	return e
+}
+
+func (e *zapEvent) Err(err error) Event {
+	e.fields = append(e.fields, zap.Error(err))
+	return e
+}
+
+func (e *zapEvent) Msg(msg string) {
+	e.logFn(msg, e.fields...)
+}
+
+type zapFieldContext struct {
+	base   *zap.Logger
+	fields []zap.Field
+}
+
+func (c *zapFieldContext) Str(key, value string) FieldContext {
+	c.fields = append(c.fields, zap.String(key, value))
+	return c
+}
+
+func (c *zapFieldContext) Int(key string, value int) FieldContext {
+	c.fields = append(c.fields, zap.Int(key, value))
+	return c
+}
+
+func (c *zapFieldContext) Err(err error) FieldContext {
+	c.fields = append(c.fields, zap.Error(err))
+	return c
+}
+
+func (c *zapFieldContext) Logger() Logger {
+	return zapLogger{l: c.base.With(c.fields...)}
+}
@@ -0,0 +1,113 @@
+package alloyinterface
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"golang.org/x/time/rate"
+)
+
+// Allow injection for testing
+var initLogProviderFn = initLogProvider
+
+// initLogProvider builds a batching OTLP log pipeline: protobuf payloads
+// are gzipped, and outbound requests run through a retrying, rate-limited
+// transport so the exporter (not individual AddLog calls) is throttled.
+func initLogProvider(ctx context.Context, cfg Config, limiter *rate.Limiter, hooks metricsHooks) (otellog.Logger, func(context.Context) error, error) {
+	tlsCfg, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The exporter ignores its own TLS and proxy options once WithHTTPClient
+	// is given, so both are set on the transport the custom client uses.
+	baseTransport := http.DefaultTransport.(*http.Transport).Clone()
+	baseTransport.TLSClientConfig = tlsCfg
+	if cfg.LogHTTPProxy != "" {
+		proxyURL, err := url.Parse(cfg.LogHTTPProxy)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid log proxy url: %v", err)
+		}
+		baseTransport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	httpOpts := []otlploghttp.Option{
+		otlploghttp.WithEndpoint(cfg.LogEndpoint),
+		otlploghttp.WithTLSClientConfig(tlsCfg),
+		otlploghttp.WithCompression(otlploghttp.GzipCompression),
+		// retryTransport already retries with its own backoff and honors
+		// Retry-After, so the exporter's built-in retry loop is disabled
+		// here - otherwise a 503/429 gets retried by both layers nested
+		// inside each other, doubling the documented worst-case elapsed time.
+		otlploghttp.WithRetry(otlploghttp.RetryConfig{Enabled: false}),
+		otlploghttp.WithHTTPClient(&http.Client{
+			Timeout:   10 * time.Second,
+			Transport: newRetryTransport(rateLimitedTransport{base: baseTransport, limiter: limiter, hooks: hooks}),
+		}),
+	}
+
+	exporter, err := otlploghttp.New(ctx, httpOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lp := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(res),
+	)
+
+	return lp.Logger(cfg.TracerName), lp.Shutdown, nil
+}
+
+func zerologToOtelSeverity(level zerolog.Level) otellog.Severity {
+	switch level {
+	case zerolog.TraceLevel:
+		return otellog.SeverityTrace
+	case zerolog.DebugLevel:
+		return otellog.SeverityDebug
+	case zerolog.InfoLevel:
+		return otellog.SeverityInfo
+	case zerolog.WarnLevel:
+		return otellog.SeverityWarn
+	case zerolog.ErrorLevel:
+		return otellog.SeverityError
+	case zerolog.FatalLevel:
+		return otellog.SeverityFatal
+	case zerolog.PanicLevel:
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+func toLogKeyValue(a attribute.KeyValue) otellog.KeyValue {
+	switch a.Value.Type() {
+	case attribute.BOOL:
+		return otellog.Bool(string(a.Key), a.Value.AsBool())
+	case attribute.INT64:
+		return otellog.Int64(string(a.Key), a.Value.AsInt64())
+	case attribute.FLOAT64:
+		return otellog.Float64(string(a.Key), a.Value.AsFloat64())
+	default:
+		return otellog.String(string(a.Key), a.Value.AsString())
+	}
+}
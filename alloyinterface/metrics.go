@@ -0,0 +1,163 @@
+package alloyinterface
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+// Allow injection for testing
+var initMeterFn = initMeter
+
+// instrumentCache lazily creates and caches OTel instruments by name so
+// callers can record a measurement without holding onto the instrument
+// handle themselves.
+type instrumentCache struct {
+	meter      metric.Meter
+	counters   sync.Map // name -> metric.Int64Counter
+	histograms sync.Map // name -> metric.Float64Histogram
+	gauges     sync.Map // name -> metric.Float64Gauge
+}
+
+func initMeter(ctx context.Context, cfg Config) (metric.Meter, func(context.Context) error, error) {
+	tlsCfg, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exporter, err := otlpmetrichttp.New(ctx,
+		otlpmetrichttp.WithEndpoint(cfg.MetricEndpoint),
+		otlpmetrichttp.WithTLSClientConfig(tlsCfg),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(cfg.MeterInterval))),
+		sdkmetric.WithResource(res),
+	)
+
+	return mp.Meter(cfg.TracerName), mp.Shutdown, nil
+}
+
+func (c *instrumentCache) counter(name string) (metric.Int64Counter, error) {
+	if v, ok := c.counters.Load(name); ok {
+		return v.(metric.Int64Counter), nil
+	}
+	inst, err := c.meter.Int64Counter(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := c.counters.LoadOrStore(name, inst)
+	return actual.(metric.Int64Counter), nil
+}
+
+func (c *instrumentCache) histogram(name string) (metric.Float64Histogram, error) {
+	if v, ok := c.histograms.Load(name); ok {
+		return v.(metric.Float64Histogram), nil
+	}
+	inst, err := c.meter.Float64Histogram(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := c.histograms.LoadOrStore(name, inst)
+	return actual.(metric.Float64Histogram), nil
+}
+
+func (c *instrumentCache) gauge(name string) (metric.Float64Gauge, error) {
+	if v, ok := c.gauges.Load(name); ok {
+		return v.(metric.Float64Gauge), nil
+	}
+	inst, err := c.meter.Float64Gauge(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := c.gauges.LoadOrStore(name, inst)
+	return actual.(metric.Float64Gauge), nil
+}
+
+func (c *instrumentCache) recordCounter(ctx context.Context, name string, value int64, attrs ...attribute.KeyValue) error {
+	inst, err := c.counter(name)
+	if err != nil {
+		return fmt.Errorf("failed to create counter %q: %w", name, err)
+	}
+	inst.Add(ctx, value, metric.WithAttributes(attrs...))
+	return nil
+}
+
+func (c *instrumentCache) recordHistogram(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) error {
+	inst, err := c.histogram(name)
+	if err != nil {
+		return fmt.Errorf("failed to create histogram %q: %w", name, err)
+	}
+	inst.Record(ctx, value, metric.WithAttributes(attrs...))
+	return nil
+}
+
+func (c *instrumentCache) recordGauge(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) error {
+	inst, err := c.gauge(name)
+	if err != nil {
+		return fmt.Errorf("failed to create gauge %q: %w", name, err)
+	}
+	inst.Record(ctx, value, metric.WithAttributes(attrs...))
+	return nil
+}
+
+// RecordCounter adds value to the named counter, creating it on first use.
+func (ac *AlloyClient) RecordCounter(ctx context.Context, name string, value int64, attrs ...attribute.KeyValue) error {
+	if ac.instruments == nil {
+		return errors.New("RecordCounter: meter not initialized")
+	}
+	return ac.instruments.recordCounter(ctx, name, value, attrs...)
+}
+
+// RecordHistogram records value against the named histogram, creating it
+// on first use.
+func (ac *AlloyClient) RecordHistogram(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) error {
+	if ac.instruments == nil {
+		return errors.New("RecordHistogram: meter not initialized")
+	}
+	return ac.instruments.recordHistogram(ctx, name, value, attrs...)
+}
+
+// RecordGauge sets the named gauge to value, creating it on first use.
+func (ac *AlloyClient) RecordGauge(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) error {
+	if ac.instruments == nil {
+		return errors.New("RecordGauge: meter not initialized")
+	}
+	return ac.instruments.recordGauge(ctx, name, value, attrs...)
+}
+
+const (
+	metricSpansStarted      = "addi.spans.started"
+	metricSpansFailed       = "addi.spans.failed"
+	metricLogShipLatency    = "addi.log.ship_latency"
+	metricRateLimitRejected = "addi.rate_limit.rejected"
+	metricHTTPInFlight      = "addi.http.in_flight_requests"
+)
+
+func (ac *AlloyClient) recordSpanStarted(ctx context.Context, tracerName string) {
+	_ = ac.RecordCounter(ctx, metricSpansStarted, 1, attribute.String("tracer_name", tracerName))
+}
+
+func (ac *AlloyClient) recordSpanFailed(ctx context.Context, tracerName string) {
+	_ = ac.RecordCounter(ctx, metricSpansFailed, 1, attribute.String("tracer_name", tracerName))
+}
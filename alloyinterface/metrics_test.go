@@ -0,0 +1,32 @@
+package alloyinterface
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestRecordCounter_NoMeter(t *testing.T) {
+	client := &AlloyClient{}
+	err := client.RecordCounter(context.Background(), "test.counter", 1)
+	assert.Error(t, err)
+}
+
+func TestRecordCounter_CachesInstrument(t *testing.T) {
+	instruments := &instrumentCache{meter: noop.NewMeterProvider().Meter("test")}
+	client := &AlloyClient{instruments: instruments}
+
+	assert.NoError(t, client.RecordCounter(context.Background(), "test.counter", 1, attribute.String("k", "v")))
+	assert.NoError(t, client.RecordHistogram(context.Background(), "test.histogram", 1.5))
+	assert.NoError(t, client.RecordGauge(context.Background(), "test.gauge", 2.5))
+
+	_, counterCached := instruments.counters.Load("test.counter")
+	_, histCached := instruments.histograms.Load("test.histogram")
+	_, gaugeCached := instruments.gauges.Load("test.gauge")
+	assert.True(t, counterCached)
+	assert.True(t, histCached)
+	assert.True(t, gaugeCached)
+}
@@ -0,0 +1,49 @@
+package alloyinterface
+
+import (
+	"io"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Option configures NewAlloyClientWithOptions. Options are applied in the
+// order they're passed, so a later option wins for the same setting;
+// WithConfigPaths is the exception and appends to earlier paths.
+type Option func(*options)
+
+type options struct {
+	logger      Logger
+	logWriter   io.Writer
+	lumberjack  *lumberjack.Logger
+	configPaths []string
+}
+
+// WithLogger overrides the default zerolog logger with any Logger
+// implementation (e.g. NewZapLogger, NewHCLogLogger) so services that have
+// standardized on a different structured logger can pass their own
+// instance instead of AlloyClient constructing one.
+func WithLogger(l Logger) Option {
+	return func(o *options) { o.logger = l }
+}
+
+// WithWriter redirects the default zerolog logger's output to w instead of
+// the lumberjack-rotated log file, so tests and containers can send logs
+// to stdout without creating a logs/ directory on disk. Ignored if
+// WithLogger is also given.
+func WithWriter(w io.Writer) Option {
+	return func(o *options) { o.logWriter = w }
+}
+
+// WithLumberjack overrides the default zerolog logger's rotation settings.
+// Ignored if WithLogger or WithWriter is also given.
+func WithLumberjack(cfg lumberjack.Logger) Option {
+	return func(o *options) { o.lumberjack = &cfg }
+}
+
+// WithConfigPaths layers conf.d-style directories of *.yaml/*.yml/*.json
+// files under the ALLOY_* environment variables, so operators can drop
+// site-specific overrides on disk while env vars still win for the keys
+// they set. See alloyconfig.ConfigLoader.AddPaths for the file format.
+func WithConfigPaths(paths ...string) Option {
+	return func(o *options) { o.configPaths = append(o.configPaths, paths...) }
+}
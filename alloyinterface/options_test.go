@@ -0,0 +1,56 @@
+package alloyinterface
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func TestInitLog_WithLogger(t *testing.T) {
+	var o options
+	WithLogger(NewZerologLogger(zerolog.Nop()))(&o)
+
+	logger, err := initLog(o)
+	assert.NoError(t, err)
+	assert.NotNil(t, logger)
+}
+
+func TestInitLog_WithWriter_NoLogsDirCreated(t *testing.T) {
+	dir := t.TempDir()
+	orig, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+	defer func() { assert.NoError(t, os.Chdir(orig)) }()
+
+	var buf bytes.Buffer
+	var o options
+	WithWriter(&buf)(&o)
+
+	logger, err := initLog(o)
+	assert.NoError(t, err)
+	logger.Info().Msg("hi")
+	assert.Contains(t, buf.String(), "hi")
+
+	_, statErr := os.Stat(filepath.Join(dir, "logs"))
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestInitLog_WithLumberjack_CustomPath(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "custom", "alloy.log")
+
+	var o options
+	WithLumberjack(lumberjack.Logger{Filename: logPath})(&o)
+
+	logger, err := initLog(o)
+	assert.NoError(t, err)
+	logger.Info().Msg("hi")
+
+	_, statErr := os.Stat(logPath)
+	assert.NoError(t, statErr)
+}
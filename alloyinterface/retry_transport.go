@@ -0,0 +1,201 @@
+package alloyinterface
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// metricsHooks lets callers observe transport-level events (rate-limit
+// rejections, in-flight request count, round-trip latency) without the
+// transport depending on AlloyClient or the metrics subsystem directly. A
+// zero-value metricsHooks is a no-op.
+type metricsHooks struct {
+	rateLimitRejectedFn func(context.Context)
+	inFlightDeltaFn     func(context.Context, int64)
+	roundTripFn         func(context.Context, time.Duration)
+}
+
+func (h metricsHooks) rateLimitRejected(ctx context.Context) {
+	if h.rateLimitRejectedFn != nil {
+		h.rateLimitRejectedFn(ctx)
+	}
+}
+
+func (h metricsHooks) inFlightDelta(ctx context.Context, delta int64) {
+	if h.inFlightDeltaFn != nil {
+		h.inFlightDeltaFn(ctx, delta)
+	}
+}
+
+func (h metricsHooks) roundTrip(ctx context.Context, d time.Duration) {
+	if h.roundTripFn != nil {
+		h.roundTripFn(ctx, d)
+	}
+}
+
+const (
+	retryInitialBackoff = 1 * time.Second
+	retryMaxBackoff     = 30 * time.Second
+	retryMaxElapsed     = 1 * time.Minute
+)
+
+// retryTransport wraps an http.RoundTripper and retries requests that fail
+// with a retryable status code or a transient network error. 429/503
+// responses honor Retry-After; other retryable failures back off
+// exponentially with jitter. 4xx responses other than 408/429 are treated
+// as terminal and returned as-is.
+type retryTransport struct {
+	base http.RoundTripper
+}
+
+func newRetryTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &retryTransport{base: base}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bodyBytes, err := drainBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	backoff := retryInitialBackoff
+
+	for {
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+			req.ContentLength = int64(len(bodyBytes))
+		}
+
+		resp, rtErr := t.base.RoundTrip(req)
+
+		var retry bool
+		wait := backoff
+		serverDirected := false
+		switch {
+		case rtErr != nil:
+			retry = isRetryableError(rtErr)
+		case resp.StatusCode < 400 || !isRetryableStatus(resp.StatusCode):
+			return resp, nil
+		default:
+			retry = true
+			if d, ok := retryAfter(resp); ok {
+				wait = d
+				serverDirected = true
+			}
+		}
+
+		if !retry || time.Since(start)+wait > retryMaxElapsed {
+			return resp, rtErr
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		if serverDirected {
+			time.Sleep(wait)
+		} else {
+			time.Sleep(jitter(wait))
+		}
+
+		backoff *= 2
+		if backoff > retryMaxBackoff {
+			backoff = retryMaxBackoff
+		}
+	}
+}
+
+func drainBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	return b, err
+}
+
+// isRetryableStatus reports whether code warrants a retry: 408/429 and any
+// 5xx. Other 4xx codes are considered terminal client errors.
+func isRetryableStatus(code int) bool {
+	if code == http.StatusRequestTimeout || code == http.StatusTooManyRequests {
+		return true
+	}
+	return code >= 500
+}
+
+func isRetryableError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout() || netErr.Temporary() //nolint:staticcheck // Temporary is deprecated but still the signal we want here
+	}
+	return false
+}
+
+// retryAfter parses a Retry-After header (seconds or HTTP-date) on 429/503
+// responses, per https://developer.mozilla.org/docs/Web/HTTP/Headers/Retry-After.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// jitter randomizes d within [0.5d, 1.0d] so that many retrying clients
+// don't thunder back at the same instant.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(float64(d) * (0.5 + rand.Float64()*0.5))
+}
+
+// rateLimitedTransport gates outbound requests through a shared rate
+// limiter before delegating to base, so the limiter throttles the exporter
+// itself rather than individual call sites.
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+	hooks   metricsHooks
+}
+
+func (t rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.limiter != nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			t.hooks.rateLimitRejected(req.Context())
+			return nil, fmt.Errorf("rate limit exceeded: %w", err)
+		}
+	}
+
+	t.hooks.inFlightDelta(req.Context(), 1)
+	defer t.hooks.inFlightDelta(req.Context(), -1)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	t.hooks.roundTrip(req.Context(), time.Since(start))
+
+	return resp, err
+}
@@ -0,0 +1,63 @@
+package alloyinterface
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// httpClientPropagator is the W3C propagator HTTPClient injects with. It's
+// passed explicitly rather than relying on otel.GetTextMapPropagator,
+// since this package never calls otel.SetTextMapPropagator and the
+// process-wide default is a no-op composite with zero propagators.
+var httpClientPropagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// StartSpan starts a new span named name as a child of whatever span is
+// already active on ctx and returns it live, so the caller can record
+// events/attributes, propagate ctx to downstream calls, and end the span
+// themselves. Unlike AddSpan and AddSpanWithAttr, which start and end a
+// span in one call, StartSpan leaves nesting and mid-span work to the
+// caller.
+func (ac *AlloyClient) StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span, error) {
+	if ac.Tracer == nil {
+		ac.Logger.Error().Msg("StartSpan: Tracer not initialized")
+		return nil, nil, errors.New("tracer not initialized")
+	}
+
+	ctx, span := ac.Tracer.Start(ctx, name, opts...)
+	ac.recordSpanStarted(ctx, name)
+	return ctx, span, nil
+}
+
+// WithSpan runs fn inside a span named name. If fn returns an error, it is
+// recorded on the span via RecordError and the span status is set to
+// codes.Error before the span ends; WithSpan then returns that same error.
+func (ac *AlloyClient) WithSpan(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, span, err := ac.StartSpan(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer span.End()
+
+	if err := fn(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// HTTPClient returns an *http.Client whose transport propagates the span
+// active on each request's context via W3C traceparent headers, so a
+// downstream service that also uses otelhttp (or any W3C-compliant
+// tracer) shows up as a child span in the same trace.
+func (ac *AlloyClient) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport, otelhttp.WithPropagators(httpClientPropagator)),
+	}
+}
@@ -0,0 +1,83 @@
+package alloyinterface
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartSpan_NoTracer(t *testing.T) {
+	client := &AlloyClient{Logger: NewZerologLogger(zerolog.Nop())}
+	ctx, span, err := client.StartSpan(context.Background(), "no-tracer")
+	assert.Nil(t, ctx)
+	assert.Nil(t, span)
+	assert.Error(t, err)
+	assert.Equal(t, "tracer not initialized", err.Error())
+}
+
+func TestStartSpan_ReturnsLiveSpan(t *testing.T) {
+	client, _ := NewAlloyClient(context.Background())
+	ctx, span, err := client.StartSpan(context.Background(), "live-span")
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+	assert.NotNil(t, span)
+	span.End()
+}
+
+func TestWithSpan_Success(t *testing.T) {
+	client, _ := NewAlloyClient(context.Background())
+	called := false
+	err := client.WithSpan(context.Background(), "with-span", func(ctx context.Context) error {
+		called = true
+		assert.NotNil(t, trace.SpanFromContext(ctx))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestWithSpan_RecordsErrorAndPropagates(t *testing.T) {
+	client, _ := NewAlloyClient(context.Background())
+	wantErr := errors.New("boom")
+	err := client.WithSpan(context.Background(), "with-span-err", func(ctx context.Context) error {
+		return wantErr
+	})
+	assert.Equal(t, wantErr, err)
+}
+
+func TestWithSpan_NoTracer(t *testing.T) {
+	client := &AlloyClient{Logger: NewZerologLogger(zerolog.Nop())}
+	err := client.WithSpan(context.Background(), "no-tracer", func(ctx context.Context) error {
+		t.Fatal("fn should not run when the tracer is missing")
+		return nil
+	})
+	assert.Error(t, err)
+}
+
+func TestHTTPClient_PropagatesTraceparentHeader(t *testing.T) {
+	var gotTraceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	client, _ := NewAlloyClient(context.Background())
+	ctx, span, err := client.StartSpan(context.Background(), "http-client-span")
+	assert.NoError(t, err)
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := client.HTTPClient().Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.NotEmpty(t, gotTraceparent)
+}